@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+// Package models contains the client-facing types backing Cilium's OpenAPI
+// spec. Every type that gets a generated DeepCopy/DeepCopyInto pair in
+// zz_generated.deepcopy.go also gets a generated DeepEqual in
+// zz_generated.deepequal.go and a generated MergeFrom in
+// zz_generated.merge.go, all three walking the same pointer/slice/map shape:
+// nil-vs-nil checks on optional fields, length checks before per-element
+// slice recursion, and delegation to nested DeepEqual/DeepCopyInto/MergeFrom
+// for embedded struct and strfmt.DateTime-like fields. Callers that only
+// need to ask "did anything change" should use DeepEqual over
+// reflect.DeepEqual or a DeepCopy-then-marshal-then-compare: it's
+// allocation-free and short-circuits on the first differing field.
+//
+// For a field that is a scalar (bool/string/int64/strfmt.DateTime, not
+// behind a pointer), MergeFrom can't tell "other reported its zero value"
+// from "other didn't report this field" by inspecting other alone. Types
+// with such fields, whether or not they also have pointer/slice/map fields,
+// take an additional <Type>Fields argument to MergeFrom whose bool members
+// name the ambiguous scalar fields; such a field is only merged when its
+// flag is set, while pointer/slice/map fields are merged as usual. Types
+// with no such ambiguity keep the plain MergeFrom(other *T) signature.
+// WireguardInterface is an exception: it keeps the plain signature and its
+// MergeFrom never merges its scalar fields.
+package models
@@ -0,0 +1,17 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package models
+
+// CloneInto copies in into dst via the generated DeepCopyInto, resetting
+// dst to its zero value first so a stale sub-struct pointer from a previous
+// use of dst never leaks into the result. This is for callers on the status
+// hot path (the daemon's status poller, clustermesh's per-cluster status
+// cache) that want to replace reflection-based cloning --
+// copystructure.Copy, or a JSON marshal/unmarshal round-trip -- both of
+// which pay for reflection and intermediate representations on every call
+// that DeepCopyInto's generated, type-specific field walk doesn't.
+func (in *StatusResponse) CloneInto(dst *StatusResponse) {
+	*dst = StatusResponse{}
+	in.DeepCopyInto(dst)
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// sampleStatusResponse returns a StatusResponse with every optional
+// sub-struct populated, so the benchmarks below exercise the full recursive
+// copy rather than a handful of nil shortcuts.
+func sampleStatusResponse() *StatusResponse {
+	return &StatusResponse{
+		BandwidthManager: &BandwidthManager{},
+		BpfMaps:          &BPFMapStatus{},
+		Cilium:           &Status{},
+		ClockSource:      &ClockSource{},
+		Cluster:          &ClusterStatus{},
+		ClusterMesh:      &ClusterMeshStatus{},
+		CniChaining:      &CNIChainingStatus{},
+		ContainerRuntime: &Status{},
+		Controllers: ControllerStatuses{
+			{Name: "controller-a"},
+			{Name: "controller-b"},
+		},
+		Encryption:           &EncryptionStatus{},
+		HostFirewall:         &HostFirewall{},
+		HostRouting:          &HostRouting{},
+		Hubble:               &HubbleStatus{},
+		IdentityRange:        &IdentityRange{},
+		Ipam:                 &IPAMStatus{},
+		IPV6BigTCP:           &IPV6BigTCP{},
+		KubeProxyReplacement: &KubeProxyReplacement{},
+		Kubernetes:           &K8sStatus{},
+		Kvstore:              &Status{},
+		Masquerading:         &Masquerading{},
+		NodeMonitor:          &MonitorStatus{},
+		Proxy:                &ProxyStatus{},
+		Stale:                map[string]strfmt.DateTime{"cilium-health": strfmt.DateTime{}},
+	}
+}
+
+// BenchmarkCloneInto_Generated exercises the generated DeepCopyInto path via
+// CloneInto, reusing a single destination across iterations the way a
+// pooled per-goroutine StatusResponse would.
+func BenchmarkCloneInto_Generated(b *testing.B) {
+	src := sampleStatusResponse()
+	dst := &StatusResponse{}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		src.CloneInto(dst)
+	}
+}
+
+// BenchmarkCloneInto_JSONRoundTrip exercises the reflection-driven
+// marshal/unmarshal clone this package's generated DeepCopy is meant to
+// replace on the status hot path.
+func BenchmarkCloneInto_JSONRoundTrip(b *testing.B) {
+	src := sampleStatusResponse()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		raw, err := json.Marshal(src)
+		if err != nil {
+			b.Fatal(err)
+		}
+		dst := &StatusResponse{}
+		if err := json.Unmarshal(raw, dst); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package models
+
+// statusResponseFields lists the StatusResponse fields Patch knows how to
+// select. Keep in sync with the field names DeepCopyInto/MergeFrom walk in
+// zz_generated.deepcopy.go / zz_generated.merge.go.
+var statusResponseFields = map[string]func(in, out *StatusResponse){
+	"bandwidth-manager": func(in, out *StatusResponse) { out.BandwidthManager = in.BandwidthManager },
+	"bpf-maps":          func(in, out *StatusResponse) { out.BpfMaps = in.BpfMaps },
+	"cilium":            func(in, out *StatusResponse) { out.Cilium = in.Cilium },
+	"clock-source":      func(in, out *StatusResponse) { out.ClockSource = in.ClockSource },
+	"cluster":           func(in, out *StatusResponse) { out.Cluster = in.Cluster },
+	"cluster-mesh":      func(in, out *StatusResponse) { out.ClusterMesh = in.ClusterMesh },
+	"cni-chaining":      func(in, out *StatusResponse) { out.CniChaining = in.CniChaining },
+	"container-runtime": func(in, out *StatusResponse) { out.ContainerRuntime = in.ContainerRuntime },
+	"controllers":       func(in, out *StatusResponse) { out.Controllers = in.Controllers },
+	"encryption":        func(in, out *StatusResponse) { out.Encryption = in.Encryption },
+	"host-firewall":     func(in, out *StatusResponse) { out.HostFirewall = in.HostFirewall },
+	"host-routing":      func(in, out *StatusResponse) { out.HostRouting = in.HostRouting },
+	"hubble":            func(in, out *StatusResponse) { out.Hubble = in.Hubble },
+	"identity-range":    func(in, out *StatusResponse) { out.IdentityRange = in.IdentityRange },
+	"ipam":              func(in, out *StatusResponse) { out.Ipam = in.Ipam },
+	"ipv6-big-tcp":      func(in, out *StatusResponse) { out.IPV6BigTCP = in.IPV6BigTCP },
+	"kube-proxy-replacement": func(in, out *StatusResponse) {
+		out.KubeProxyReplacement = in.KubeProxyReplacement
+	},
+	"kubernetes":   func(in, out *StatusResponse) { out.Kubernetes = in.Kubernetes },
+	"kvstore":      func(in, out *StatusResponse) { out.Kvstore = in.Kvstore },
+	"masquerading": func(in, out *StatusResponse) { out.Masquerading = in.Masquerading },
+	"node-monitor": func(in, out *StatusResponse) { out.NodeMonitor = in.NodeMonitor },
+	"proxy":        func(in, out *StatusResponse) { out.Proxy = in.Proxy },
+	"stale":        func(in, out *StatusResponse) { out.Stale = in.Stale },
+}
+
+// Patch returns a new StatusResponse populated with only the fields named in
+// fieldMask, copied shallowly from in. Names not recognized by
+// statusResponseFields are ignored. This is intended for serving
+// JSON-Merge-Patch (RFC 7396) style partial status updates, where a client
+// that asked for e.g. "cluster-mesh,hubble" should get back a response with
+// every other field left zero rather than paying to assemble the whole
+// struct.
+func (in *StatusResponse) Patch(fieldMask []string) *StatusResponse {
+	out := &StatusResponse{}
+	for _, name := range fieldMask {
+		if set, ok := statusResponseFields[name]; ok {
+			set(in, out)
+		}
+	}
+	return out
+}
@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+// Package models contains hand-written, idiomatic Go equivalents of the
+// subset of api/v1/models types that daemon and CLI code reasons about
+// directly. The api/v1/models package is generated from the OpenAPI spec and
+// is shaped by wire concerns: every optional field is a pointer, addresses
+// and CIDRs are plain strings, and timestamps are strfmt.DateTime. None of
+// that is pleasant to compute with, and a field rename or type change in the
+// wire schema ripples into every caller that imports models directly.
+//
+// Types here use netip.Addr/netip.Prefix for addresses, time.Time for
+// timestamps, and typed enums in place of free-form strings. Conversions
+// to and from the wire types live in zz_generated.conversion.go, named
+// ConvertTo_v1_X/ConvertFrom_v1_X after the models.X they correspond to,
+// following the shape (if not the machinery) of Kubernetes' conversion-gen.
+//
+// This package only covers the types daemon/CLI code has been migrated to
+// use so far: ControllerStatus, NodeAddressing, and the NodePort feature of
+// KubeProxyReplacement. Extend it type by type as more callers move off the
+// wire models rather than converting the whole API surface up front.
+package models
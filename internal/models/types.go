@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package models
+
+import (
+	"net/netip"
+	"time"
+)
+
+// NodeAddressingElement is the idiomatic counterpart of
+// models.NodeAddressingElement: an address family's allocation range for a
+// node, plus the node's own IP in that family.
+type NodeAddressingElement struct {
+	// Enabled reports whether Cilium manages this address family on the node.
+	Enabled bool
+
+	// IP is the node's own address in this family. The zero value means no
+	// address was set.
+	IP netip.Addr
+
+	// AllocRange is the CIDR Cilium allocates pod addresses from on this
+	// node. Invalid means no allocation range was set.
+	AllocRange netip.Prefix
+}
+
+// NodeAddressing is the idiomatic counterpart of models.NodeAddressing.
+type NodeAddressing struct {
+	IPV4 *NodeAddressingElement
+	IPV6 *NodeAddressingElement
+}
+
+// ControllerStatus is the idiomatic counterpart of models.ControllerStatus.
+type ControllerStatus struct {
+	Name          string
+	UUID          string
+	Configuration *ControllerStatusConfiguration
+	Status        *ControllerStatusStatus
+}
+
+// ControllerStatusConfiguration is the idiomatic counterpart of
+// models.ControllerStatusConfiguration.
+type ControllerStatusConfiguration struct {
+	ErrorRetryBase time.Duration
+	Interval       time.Duration
+}
+
+// ControllerStatusStatus is the idiomatic counterpart of
+// models.ControllerStatusStatus.
+type ControllerStatusStatus struct {
+	ConsecutiveFailureCount int64
+	FailureCount            int64
+	SuccessCount            int64
+	LastFailureMsg          string
+	LastFailureTimestamp    time.Time
+	LastSuccessTimestamp    time.Time
+}
+
+// NodePortMode is the SNAT strategy kube-proxy replacement uses for
+// NodePort/LoadBalancer traffic, in place of models.
+// KubeProxyReplacementFeaturesNodePort's free-form Mode string.
+type NodePortMode string
+
+const (
+	NodePortModeUnknown NodePortMode = ""
+	NodePortModeSNAT    NodePortMode = "snat"
+	NodePortModeDSR     NodePortMode = "dsr"
+	NodePortModeHybrid  NodePortMode = "hybrid"
+)
+
+// NodePortFeature is the idiomatic counterpart of
+// models.KubeProxyReplacementFeaturesNodePort.
+type NodePortFeature struct {
+	Enabled bool
+	Mode    NodePortMode
+	PortMin int64
+	PortMax int64
+}
@@ -45,5 +45,48 @@ func init() {
 	flags.String(operatorOption.EC2APIEndpoint, "", "AWS API endpoint for the EC2 service")
 	regOpts.BindEnv(operatorOption.EC2APIEndpoint)
 
+	flags.Bool(operatorOption.AWSIMDSOnlyProvisioning, false,
+		"Rely solely on the IMDS data each node's cilium-agent reports on CiliumNode status for ENI/IP reconciliation, "+
+			"instead of calling the EC2 API; skips ENI tag reconciliation and leaked-ENI cleanup")
+	regOpts.BindEnv(operatorOption.AWSIMDSOnlyProvisioning)
+
+	flags.Bool(operatorOption.AWSEnablePodENI, false,
+		"Allocate a trunk ENI per supported instance type and branch ENIs on demand, so pods can be assigned "+
+			"dedicated security groups instead of sharing the node's")
+	regOpts.BindEnv(operatorOption.AWSEnablePodENI)
+
+	flags.StringSlice(operatorOption.AWSPodENISecurityGroups, nil,
+		"Security group IDs to attach to branch ENIs allocated for pods that request one; "+
+			"only used when --aws-enable-pod-eni is set")
+	regOpts.BindEnv(operatorOption.AWSPodENISecurityGroups)
+
+	flags.StringSlice(operatorOption.AWSPodENISubnets, nil,
+		"Subnet IDs eligible for branch ENI allocation; only used when --aws-enable-pod-eni is set")
+	regOpts.BindEnv(operatorOption.AWSPodENISubnets)
+
+	flags.Bool(operatorOption.AWSCloudWatchMetricsEnabled, false,
+		"Periodically export the operator's IPAM Prometheus gauges/counters to CloudWatch PutMetricData, "+
+			"dimensioned by instance/region/availability-zone")
+	regOpts.BindEnv(operatorOption.AWSCloudWatchMetricsEnabled)
+
+	flags.String(operatorOption.AWSCloudWatchMetricsNamespace, "Cilium",
+		"CloudWatch namespace to publish IPAM metrics under; only used when --aws-cloudwatch-metrics-enabled is set")
+	regOpts.BindEnv(operatorOption.AWSCloudWatchMetricsNamespace)
+
+	flags.Int(operatorOption.AWSCloudWatchMetricsInterval, 60,
+		"Number of seconds between CloudWatch PutMetricData exports; only used when --aws-cloudwatch-metrics-enabled is set")
+	regOpts.BindEnv(operatorOption.AWSCloudWatchMetricsInterval)
+
+	flags.Var(option.NewNamedMapOptions(operatorOption.AWSPrefixDelegationInstanceTypes, &operatorOption.Config.AWSPrefixDelegationInstanceTypes, nil),
+		operatorOption.AWSPrefixDelegationInstanceTypes,
+		`Add or overwrite per-instance-type overrides of prefix delegation and warm pool targets, in the form of `+
+			`{"AWS instance type": "enable_prefix_delegation,warm_prefix_target,warm_ip_target,min_allocate"}. `+
+			`The ENI allocator consults this map before falling back to `+operatorOption.AWSEnablePrefixDelegation+
+			` and the global warm pool flags. cli example: `+
+			`--aws-prefix-delegation-instance-types=m5.large=true,1,16,8 `+
+			`--aws-prefix-delegation-instance-types=m4.large=false,0,8,4 `+
+			`configmap example: {"m5.large": "true,1,16,8", "m4.large": "false,0,8,4"}`)
+	regOpts.BindEnv(operatorOption.AWSPrefixDelegationInstanceTypes)
+
 	Vp.BindPFlags(flags)
 }
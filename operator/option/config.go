@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+// Package option holds the operator's command-line flag names and the
+// OperatorConfig struct that flags bound via flags.Var (as opposed to
+// viper) write directly into.
+package option
+
+const (
+	// AWSInstanceLimitMapping allows overriding AWS instance type to
+	// ENI/IP limit mappings that are not yet known to the built-in table.
+	AWSInstanceLimitMapping = "aws-instance-limit-mapping"
+
+	// AWSReleaseExcessIPs allows releasing excess free IP addresses from AWS ENI.
+	AWSReleaseExcessIPs = "aws-release-excess-ips"
+
+	// ExcessIPReleaseDelay is the number of seconds the operator waits
+	// before releasing an IP previously marked as excess.
+	ExcessIPReleaseDelay = "excess-ip-release-delay"
+
+	// AWSEnablePrefixDelegation allows the operator to allocate prefixes
+	// to ENIs instead of individual IP addresses.
+	AWSEnablePrefixDelegation = "aws-enable-prefix-delegation"
+
+	// ENITags are additional tags to apply to ENIs created by the operator.
+	ENITags = "eni-tags"
+
+	// UpdateEC2AdapterLimitViaAPI enables using the EC2 API to update the
+	// instance type to adapter limits.
+	UpdateEC2AdapterLimitViaAPI = "update-ec2-adapter-limit-via-api"
+
+	// AWSUsePrimaryAddress allows using the primary address of the ENI
+	// for allocations on the node.
+	AWSUsePrimaryAddress = "aws-use-primary-address"
+
+	// EC2APIEndpoint is the AWS API endpoint for the EC2 service.
+	EC2APIEndpoint = "ec2-api-endpoint"
+
+	// AWSIMDSOnlyProvisioning relies solely on the IMDS data each node's
+	// cilium-agent reports on CiliumNode status for ENI/IP reconciliation,
+	// instead of calling the EC2 API.
+	AWSIMDSOnlyProvisioning = "aws-imds-only-provisioning"
+
+	// AWSEnablePodENI allocates a trunk ENI per supported instance type
+	// and branch ENIs on demand, so pods can be assigned dedicated
+	// security groups instead of sharing the node's.
+	AWSEnablePodENI = "aws-enable-pod-eni"
+
+	// AWSPodENISecurityGroups are the security group IDs to attach to
+	// branch ENIs allocated for pods that request one.
+	AWSPodENISecurityGroups = "aws-pod-eni-security-groups"
+
+	// AWSPodENISubnets are the subnet IDs eligible for branch ENI
+	// allocation.
+	AWSPodENISubnets = "aws-pod-eni-subnets"
+
+	// AWSCloudWatchMetricsEnabled periodically exports the operator's IPAM
+	// Prometheus gauges/counters to CloudWatch PutMetricData, dimensioned
+	// by instance/region/availability-zone.
+	AWSCloudWatchMetricsEnabled = "aws-cloudwatch-metrics-enabled"
+
+	// AWSCloudWatchMetricsNamespace is the CloudWatch namespace to publish
+	// IPAM metrics under.
+	AWSCloudWatchMetricsNamespace = "aws-cloudwatch-metrics-namespace"
+
+	// AWSCloudWatchMetricsInterval is the number of seconds between
+	// CloudWatch PutMetricData exports.
+	AWSCloudWatchMetricsInterval = "aws-cloudwatch-metrics-interval"
+
+	// AWSPrefixDelegationInstanceTypes allows overriding prefix delegation
+	// and warm pool targets on a per-instance-type basis.
+	AWSPrefixDelegationInstanceTypes = "aws-prefix-delegation-instance-types"
+)
+
+// OperatorConfig holds operator flag values that are bound directly by
+// pointer (via flags.Var) rather than through viper.
+type OperatorConfig struct {
+	// AWSInstanceLimitMapping is the set of user-supplied AWS instance
+	// limit overrides, keyed by AWS instance type.
+	AWSInstanceLimitMapping map[string]string
+
+	// ENITags are the additional tags applied to ENIs created by the
+	// operator, keyed by tag name.
+	ENITags map[string]string
+
+	// AWSPrefixDelegationInstanceTypes are the user-supplied per-instance-type
+	// overrides of prefix delegation and warm pool targets, keyed by AWS
+	// instance type.
+	AWSPrefixDelegationInstanceTypes map[string]string
+}
+
+// Config is the global instance of OperatorConfig that flags registered in
+// this package's consumers are bound against.
+var Config = &OperatorConfig{}
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cilium/cilium/api/v1/models"
+)
+
+// Apply applies patch to prev and returns the resulting StatusResponse.
+// Unlike Diff, Apply isn't on the hot path -- it runs once per received
+// update rather than once per field per poll -- so it takes the simple route
+// of mutating a generic JSON document rather than walking typed fields, and
+// round-trips through encoding/json at the edges.
+func Apply(prev *models.StatusResponse, patch []JSONPatchOp) (*models.StatusResponse, error) {
+	if prev == nil {
+		prev = &models.StatusResponse{}
+	}
+
+	raw, err := json.Marshal(prev)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling base document: %w", err)
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshaling base document: %w", err)
+	}
+
+	for i, op := range patch {
+		var err error
+		doc, err = applyOp(doc, op)
+		if err != nil {
+			return nil, fmt.Errorf("op %d (%s %s): %w", i, op.Op, op.Path, err)
+		}
+	}
+
+	merged, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling patched document: %w", err)
+	}
+
+	out := &models.StatusResponse{}
+	if err := json.Unmarshal(merged, out); err != nil {
+		return nil, fmt.Errorf("unmarshaling patched document: %w", err)
+	}
+
+	return out, nil
+}
+
+// applyOp applies a single JSON Patch operation to doc and returns the
+// resulting document. doc is the generic any produced by unmarshaling into
+// an interface{}: map[string]interface{}, []interface{}, or a scalar.
+func applyOp(doc interface{}, op JSONPatchOp) (interface{}, error) {
+	tokens, err := splitPointer(op.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case OpAdd, OpReplace:
+		return setAtPointer(doc, tokens, op.Value)
+	case OpRemove:
+		return removeAtPointer(doc, tokens)
+	default:
+		return nil, fmt.Errorf("unsupported op %q", op.Op)
+	}
+}
+
+// splitPointer splits an RFC 6901 JSON Pointer into its unescaped tokens.
+// The root pointer "" splits to an empty token list.
+func splitPointer(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("path %q must start with /", path)
+	}
+
+	raw := strings.Split(path[1:], "/")
+	tokens := make([]string, len(raw))
+	for i, t := range raw {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(t)
+	}
+	return tokens, nil
+}
+
+// setAtPointer handles both "add" and "replace". For array elements this
+// only supports "-" (append) and an existing index (overwrite in place);
+// it does not implement RFC 6902's insert-and-shift semantics for "add" at
+// an arbitrary in-bounds index, since Diff never emits one -- it always
+// either appends at "-" or replaces an index that exists in both snapshots.
+func setAtPointer(doc interface{}, tokens []string, value interface{}) (interface{}, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+
+	parent, key, err := navigateToParent(doc, tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		p[key] = value
+		return doc, nil
+	case []interface{}:
+		idx, arr, err := arrayIndex(p, key)
+		if err != nil {
+			return nil, err
+		}
+		if idx == len(arr) {
+			return doc, replaceSlice(doc, tokens[:len(tokens)-1], append(arr, value))
+		}
+		arr[idx] = value
+		return doc, nil
+	default:
+		return nil, fmt.Errorf("cannot set member of %T", parent)
+	}
+}
+
+func removeAtPointer(doc interface{}, tokens []string) (interface{}, error) {
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("cannot remove the document root")
+	}
+
+	parent, key, err := navigateToParent(doc, tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		delete(p, key)
+		return doc, nil
+	case []interface{}:
+		idx, arr, err := arrayIndex(p, key)
+		if err != nil {
+			return nil, err
+		}
+		if idx >= len(arr) {
+			return nil, fmt.Errorf("index %d out of range", idx)
+		}
+		return doc, replaceSlice(doc, tokens[:len(tokens)-1], append(arr[:idx], arr[idx+1:]...))
+	default:
+		return nil, fmt.Errorf("cannot remove member of %T", parent)
+	}
+}
+
+// navigateToParent walks doc through all but the last token and returns the
+// container the last token indexes into, along with that last token.
+func navigateToParent(doc interface{}, tokens []string) (interface{}, string, error) {
+	cur := doc
+	for _, t := range tokens[:len(tokens)-1] {
+		switch c := cur.(type) {
+		case map[string]interface{}:
+			next, ok := c[t]
+			if !ok {
+				return nil, "", fmt.Errorf("no such member %q", t)
+			}
+			cur = next
+		case []interface{}:
+			idx, err := strconv.Atoi(t)
+			if err != nil || idx < 0 || idx >= len(c) {
+				return nil, "", fmt.Errorf("invalid array index %q", t)
+			}
+			cur = c[idx]
+		default:
+			return nil, "", fmt.Errorf("cannot descend into %T", cur)
+		}
+	}
+	return cur, tokens[len(tokens)-1], nil
+}
+
+// arrayIndex resolves a JSON Pointer array token (a decimal index, or "-"
+// for one-past-the-end) against arr.
+func arrayIndex(arr []interface{}, token string) (int, []interface{}, error) {
+	if token == "-" {
+		return len(arr), arr, nil
+	}
+	idx, err := strconv.Atoi(token)
+	if err != nil || idx < 0 || idx > len(arr) {
+		return 0, nil, fmt.Errorf("invalid array index %q", token)
+	}
+	return idx, arr, nil
+}
+
+// replaceSlice writes a modified slice back into its parent container at
+// the location tokens points to, since Go slices grown by append don't
+// mutate the original backing array's owner in place.
+func replaceSlice(doc interface{}, tokens []string, arr []interface{}) error {
+	if len(tokens) == 0 {
+		return fmt.Errorf("cannot replace the document root slice in place")
+	}
+
+	parent, key, err := navigateToParent(doc, tokens)
+	if err != nil {
+		return err
+	}
+
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		p[key] = arr
+		return nil
+	case []interface{}:
+		idx, err := strconv.Atoi(key)
+		if err != nil || idx < 0 || idx >= len(p) {
+			return fmt.Errorf("invalid array index %q", key)
+		}
+		p[idx] = arr
+		return nil
+	default:
+		return fmt.Errorf("cannot replace member of %T", parent)
+	}
+}
@@ -0,0 +1,305 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+// Package diff computes and applies RFC 6902 JSON Patch documents between
+// two models.StatusResponse snapshots, so a status stream can push a few
+// hundred bytes of deltas instead of the full object on every refresh.
+package diff
+
+import (
+	"fmt"
+
+	"github.com/cilium/cilium/api/v1/models"
+)
+
+// Op is one of the RFC 6902 operations this package emits. We only ever
+// produce add/remove/replace: StatusResponse has no use for copy/move/test.
+type Op string
+
+const (
+	OpAdd     Op = "add"
+	OpRemove  Op = "remove"
+	OpReplace Op = "replace"
+)
+
+// JSONPatchOp is a single operation of an RFC 6902 JSON Patch document.
+type JSONPatchOp struct {
+	Op Op `json:"op"`
+
+	// Path is an RFC 6901 JSON Pointer, e.g. "/controllers/2/status".
+	Path string `json:"path"`
+
+	// Value is the new value for add/replace; omitted for remove.
+	Value interface{} `json:"value,omitempty"`
+}
+
+// statusResponseFieldOrder fixes the iteration order Diff walks
+// StatusResponse's top-level fields in, so the same pair of snapshots always
+// yields byte-identical patches.
+var statusResponseFieldOrder = []string{
+	"bandwidth-manager", "bpf-maps", "cilium", "clock-source", "cluster",
+	"cluster-mesh", "cni-chaining", "container-runtime", "controllers",
+	"encryption", "host-firewall", "host-routing", "hubble",
+	"identity-range", "ipam", "ipv6-big-tcp", "kube-proxy-replacement",
+	"kubernetes", "kvstore", "masquerading", "node-monitor", "proxy", "stale",
+}
+
+// Diff computes the RFC 6902 JSON Patch that turns prev into next. A nil
+// prev is treated as an all-fields-absent StatusResponse; a nil next yields
+// a patch that removes every field prev had set.
+//
+// Diff recurses into the three field graphs this is most valuable for --
+// Controllers, WireguardStatus.Interfaces[*].Peers[*], and
+// ClusterMeshStatus.Clusters -- by index, matching the generated
+// DeepCopyInto traversal. Every other optional field is diffed as a whole:
+// if it changed at all, the patch replaces it wholesale rather than
+// recursing further, since those fields don't carry the large repeated
+// sub-collections that make partial diffing worthwhile.
+func Diff(prev, next *models.StatusResponse) ([]JSONPatchOp, error) {
+	if prev == nil {
+		prev = &models.StatusResponse{}
+	}
+	if next == nil {
+		next = &models.StatusResponse{}
+	}
+
+	var ops []JSONPatchOp
+
+	for _, field := range statusResponseFieldOrder {
+		fieldOps, err := diffField(field, prev, next)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", field, err)
+		}
+		ops = append(ops, fieldOps...)
+	}
+
+	return ops, nil
+}
+
+// diffField diffs a single top-level StatusResponse field, dispatching to a
+// deeper per-index diff for the fields Diff's doc comment calls out.
+func diffField(field string, prev, next *models.StatusResponse) ([]JSONPatchOp, error) {
+	switch field {
+	case "controllers":
+		return diffControllers("/controllers", prev.Controllers, next.Controllers), nil
+	case "cluster-mesh":
+		return diffClusterMesh("/cluster-mesh", prev.ClusterMesh, next.ClusterMesh), nil
+	case "encryption":
+		return diffEncryption("/encryption", prev.Encryption, next.Encryption), nil
+	default:
+		prevVal, nextVal := fieldValue(field, prev), fieldValue(field, next)
+		equal := !isNilInterface(prevVal) && !isNilInterface(nextVal) && fieldEqual(field, prev, next)
+		return diffWholeField(field, prevVal, nextVal, equal), nil
+	}
+}
+
+// diffEncryption diffs EncryptionStatus by recursing into its Wireguard
+// status's Interfaces[*].Peers[*], the other nested collection Diff's doc
+// comment calls out by name.
+func diffEncryption(base string, prev, next *models.EncryptionStatus) []JSONPatchOp {
+	switch {
+	case prev == nil && next == nil:
+		return nil
+	case prev == nil:
+		return []JSONPatchOp{{Op: OpAdd, Path: base, Value: next}}
+	case next == nil:
+		return []JSONPatchOp{{Op: OpRemove, Path: base}}
+	}
+
+	switch {
+	case prev.Wireguard == nil && next.Wireguard == nil:
+		return nil
+	case prev.Wireguard == nil:
+		return []JSONPatchOp{{Op: OpAdd, Path: base + "/wireguard", Value: next.Wireguard}}
+	case next.Wireguard == nil:
+		return []JSONPatchOp{{Op: OpRemove, Path: base + "/wireguard"}}
+	}
+
+	return diffWireguardInterfaces(base+"/wireguard/interfaces", prev.Wireguard.Interfaces, next.Wireguard.Interfaces)
+}
+
+// diffWholeField emits a single add/remove/replace for a field that Diff
+// doesn't recurse into.
+func diffWholeField(field string, prevVal, nextVal interface{}, equal bool) []JSONPatchOp {
+	prevSet, nextSet := !isNilInterface(prevVal), !isNilInterface(nextVal)
+	path := "/" + field
+
+	switch {
+	case !prevSet && !nextSet:
+		return nil
+	case !prevSet && nextSet:
+		return []JSONPatchOp{{Op: OpAdd, Path: path, Value: nextVal}}
+	case prevSet && !nextSet:
+		return []JSONPatchOp{{Op: OpRemove, Path: path}}
+	case equal:
+		return nil
+	default:
+		return []JSONPatchOp{{Op: OpReplace, Path: path, Value: nextVal}}
+	}
+}
+
+// diffControllers diffs two ControllerStatuses slices index by index:
+// shared indices that differ are replaced in place, a longer next appends
+// new entries, and a longer prev removes trailing entries starting from the
+// highest index so earlier removals don't shift the indices still to come.
+//
+// Detecting "differ" at a shared index delegates entirely to
+// ControllerStatus.DeepEqual, so a real-world status change at this index
+// is only caught here if DeepEqual (and, transitively,
+// ControllerStatusStatus.DeepEqual) compares every field the wire type
+// carries, not just a subset.
+func diffControllers(base string, prev, next models.ControllerStatuses) []JSONPatchOp {
+	var ops []JSONPatchOp
+
+	common := len(prev)
+	if len(next) < common {
+		common = len(next)
+	}
+
+	for i := 0; i < common; i++ {
+		if prev[i] == nil && next[i] == nil {
+			continue
+		}
+		if prev[i] == nil || next[i] == nil || !prev[i].DeepEqual(next[i]) {
+			ops = append(ops, JSONPatchOp{Op: OpReplace, Path: indexPath(base, i), Value: next[i]})
+		}
+	}
+
+	for i := common; i < len(next); i++ {
+		ops = append(ops, JSONPatchOp{Op: OpAdd, Path: base + "/-", Value: next[i]})
+	}
+
+	for i := len(prev) - 1; i >= common; i-- {
+		ops = append(ops, JSONPatchOp{Op: OpRemove, Path: indexPath(base, i)})
+	}
+
+	return ops
+}
+
+// diffClusterMesh diffs ClusterMeshStatus by recursing into its Clusters
+// slice by index, the same way diffControllers does for Controllers.
+func diffClusterMesh(base string, prev, next *models.ClusterMeshStatus) []JSONPatchOp {
+	switch {
+	case prev == nil && next == nil:
+		return nil
+	case prev == nil:
+		return []JSONPatchOp{{Op: OpAdd, Path: base, Value: next}}
+	case next == nil:
+		return []JSONPatchOp{{Op: OpRemove, Path: base}}
+	}
+
+	return diffRemoteClusters(base+"/clusters", prev.Clusters, next.Clusters)
+}
+
+func diffRemoteClusters(base string, prev, next []*models.RemoteCluster) []JSONPatchOp {
+	var ops []JSONPatchOp
+
+	common := len(prev)
+	if len(next) < common {
+		common = len(next)
+	}
+
+	for i := 0; i < common; i++ {
+		if prev[i] == nil && next[i] == nil {
+			continue
+		}
+		if prev[i] == nil || next[i] == nil || !prev[i].DeepEqual(next[i]) {
+			ops = append(ops, JSONPatchOp{Op: OpReplace, Path: indexPath(base, i), Value: next[i]})
+		}
+	}
+
+	for i := common; i < len(next); i++ {
+		ops = append(ops, JSONPatchOp{Op: OpAdd, Path: base + "/-", Value: next[i]})
+	}
+
+	for i := len(prev) - 1; i >= common; i-- {
+		ops = append(ops, JSONPatchOp{Op: OpRemove, Path: indexPath(base, i)})
+	}
+
+	return ops
+}
+
+// diffWireguardInterfaces diffs WireguardStatus.Interfaces by index,
+// recursing further into each interface's Peers by index in turn.
+func diffWireguardInterfaces(base string, prev, next []*models.WireguardInterface) []JSONPatchOp {
+	var ops []JSONPatchOp
+
+	common := len(prev)
+	if len(next) < common {
+		common = len(next)
+	}
+
+	for i := 0; i < common; i++ {
+		switch {
+		case prev[i] == nil && next[i] == nil:
+			continue
+		case prev[i] == nil || next[i] == nil:
+			ops = append(ops, JSONPatchOp{Op: OpReplace, Path: indexPath(base, i), Value: next[i]})
+		case !prev[i].DeepEqual(next[i]):
+			if wireguardInterfaceEqualExceptPeers(prev[i], next[i]) {
+				ops = append(ops, diffWireguardPeers(indexPath(base, i)+"/peers", prev[i].Peers, next[i].Peers)...)
+			} else {
+				// A field other than Peers changed too (e.g. ListenPort,
+				// PublicKey): replace the whole interface rather than
+				// recursing into Peers alone, which would silently drop
+				// the other change.
+				ops = append(ops, JSONPatchOp{Op: OpReplace, Path: indexPath(base, i), Value: next[i]})
+			}
+		}
+	}
+
+	for i := common; i < len(next); i++ {
+		ops = append(ops, JSONPatchOp{Op: OpAdd, Path: base + "/-", Value: next[i]})
+	}
+
+	for i := len(prev) - 1; i >= common; i-- {
+		ops = append(ops, JSONPatchOp{Op: OpRemove, Path: indexPath(base, i)})
+	}
+
+	return ops
+}
+
+// wireguardInterfaceEqualExceptPeers reports whether a and b have the same
+// fields other than Peers, by substituting b's Peers into a copy of a and
+// comparing that against b with DeepEqual.
+func wireguardInterfaceEqualExceptPeers(a, b *models.WireguardInterface) bool {
+	aCopy := *a
+	aCopy.Peers = b.Peers
+	return aCopy.DeepEqual(b)
+}
+
+func diffWireguardPeers(base string, prev, next []*models.WireguardPeer) []JSONPatchOp {
+	var ops []JSONPatchOp
+
+	common := len(prev)
+	if len(next) < common {
+		common = len(next)
+	}
+
+	for i := 0; i < common; i++ {
+		if prev[i] == nil && next[i] == nil {
+			continue
+		}
+		if prev[i] == nil || next[i] == nil || !prev[i].DeepEqual(next[i]) {
+			ops = append(ops, JSONPatchOp{Op: OpReplace, Path: indexPath(base, i), Value: next[i]})
+		}
+	}
+
+	for i := common; i < len(next); i++ {
+		ops = append(ops, JSONPatchOp{Op: OpAdd, Path: base + "/-", Value: next[i]})
+	}
+
+	for i := len(prev) - 1; i >= common; i-- {
+		ops = append(ops, JSONPatchOp{Op: OpRemove, Path: indexPath(base, i)})
+	}
+
+	return ops
+}
+
+func indexPath(base string, i int) string {
+	return fmt.Sprintf("%s/%d", base, i)
+}
+
+func isNilInterface(v interface{}) bool {
+	return v == nil
+}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package diff
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/cilium/cilium/api/v1/models"
+)
+
+// fieldValue returns prev or next's value for one of statusResponseFieldOrder's
+// names as an interface{}, or a nil interface if the field's pointer is nil.
+// Keep in sync with fieldEqual and statusResponseFieldOrder.
+func fieldValue(field string, s *models.StatusResponse) interface{} {
+	switch field {
+	case "bandwidth-manager":
+		return ptrOrNil(s.BandwidthManager)
+	case "bpf-maps":
+		return ptrOrNil(s.BpfMaps)
+	case "cilium":
+		return ptrOrNil(s.Cilium)
+	case "clock-source":
+		return ptrOrNil(s.ClockSource)
+	case "cluster":
+		return ptrOrNil(s.Cluster)
+	case "cni-chaining":
+		return ptrOrNil(s.CniChaining)
+	case "container-runtime":
+		return ptrOrNil(s.ContainerRuntime)
+	case "host-firewall":
+		return ptrOrNil(s.HostFirewall)
+	case "host-routing":
+		return ptrOrNil(s.HostRouting)
+	case "hubble":
+		return ptrOrNil(s.Hubble)
+	case "identity-range":
+		return ptrOrNil(s.IdentityRange)
+	case "ipam":
+		return ptrOrNil(s.Ipam)
+	case "ipv6-big-tcp":
+		return ptrOrNil(s.IPV6BigTCP)
+	case "kube-proxy-replacement":
+		return ptrOrNil(s.KubeProxyReplacement)
+	case "kubernetes":
+		return ptrOrNil(s.Kubernetes)
+	case "kvstore":
+		return ptrOrNil(s.Kvstore)
+	case "masquerading":
+		return ptrOrNil(s.Masquerading)
+	case "node-monitor":
+		return ptrOrNil(s.NodeMonitor)
+	case "proxy":
+		return ptrOrNil(s.Proxy)
+	case "stale":
+		if s.Stale == nil {
+			return nil
+		}
+		return s.Stale
+	default:
+		return nil
+	}
+}
+
+// fieldEqual reports whether field holds DeepEqual values in prev and next.
+// Only called once both sides are known non-nil, so it can delegate straight
+// to the generated DeepEqual method instead of re-checking nilness.
+func fieldEqual(field string, prev, next *models.StatusResponse) bool {
+	switch field {
+	case "bandwidth-manager":
+		return prev.BandwidthManager.DeepEqual(next.BandwidthManager)
+	case "bpf-maps":
+		return prev.BpfMaps.DeepEqual(next.BpfMaps)
+	case "cilium":
+		return *prev.Cilium == *next.Cilium
+	case "clock-source":
+		return *prev.ClockSource == *next.ClockSource
+	case "cluster":
+		return prev.Cluster.DeepEqual(next.Cluster)
+	case "cni-chaining":
+		return *prev.CniChaining == *next.CniChaining
+	case "container-runtime":
+		return *prev.ContainerRuntime == *next.ContainerRuntime
+	case "host-firewall":
+		return prev.HostFirewall.DeepEqual(next.HostFirewall)
+	case "host-routing":
+		return prev.HostRouting.DeepEqual(next.HostRouting)
+	case "hubble":
+		return prev.Hubble.DeepEqual(next.Hubble)
+	case "identity-range":
+		return prev.IdentityRange.DeepEqual(next.IdentityRange)
+	case "ipam":
+		return prev.Ipam.DeepEqual(next.Ipam)
+	case "ipv6-big-tcp":
+		return prev.IPV6BigTCP.DeepEqual(next.IPV6BigTCP)
+	case "kube-proxy-replacement":
+		return prev.KubeProxyReplacement.DeepEqual(next.KubeProxyReplacement)
+	case "kubernetes":
+		return prev.Kubernetes.DeepEqual(next.Kubernetes)
+	case "kvstore":
+		return *prev.Kvstore == *next.Kvstore
+	case "masquerading":
+		return prev.Masquerading.DeepEqual(next.Masquerading)
+	case "node-monitor":
+		return *prev.NodeMonitor == *next.NodeMonitor
+	case "proxy":
+		return prev.Proxy.DeepEqual(next.Proxy)
+	case "stale":
+		return staleEqual(prev.Stale, next.Stale)
+	default:
+		return true
+	}
+}
+
+// ptrOrNil returns p as an interface{}, or a nil interface if p is nil. A
+// plain `interface{}(p)` for a nil *T is non-nil as an interface, which
+// would make diffWholeField treat an absent field as present; this collapses
+// that case back to a true nil.
+func ptrOrNil[T any](p *T) interface{} {
+	if p == nil {
+		return nil
+	}
+	return p
+}
+
+// staleEqual compares StatusResponse.Stale (map[string]strfmt.DateTime) the
+// same way the generated DeepEqual for StatusResponse does: per-key,
+// comparing timestamps with time.Time.Equal rather than ==.
+func staleEqual(a, b map[string]strfmt.DateTime) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, aVal := range a {
+		bVal, ok := b[key]
+		if !ok || !time.Time(aVal).Equal(time.Time(bVal)) {
+			return false
+		}
+	}
+	return true
+}
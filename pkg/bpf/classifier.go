@@ -0,0 +1,165 @@
+package bpf
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/btf"
+)
+
+// ProgramClassifier assigns an ebpf.ProgramType (and, where applicable, an
+// ebpf.AttachType) to a single ProgramSpec that the underlying library
+// couldn't classify from its ELF section name alone. Each program in a spec
+// is classified independently, so a single ELF may freely mix program types.
+type ProgramClassifier interface {
+	// Classify returns the type to assign to p, or ok == false if this
+	// classifier has no opinion and the next one in the chain should be
+	// consulted.
+	Classify(name string, p *ebpf.ProgramSpec) (typ ebpf.ProgramType, attach ebpf.AttachType, ok bool)
+}
+
+// sectionPrefixRule classifies a program by a literal prefix match against
+// its ELF section name, e.g. "tc/ingress" -> SchedCLS.
+type sectionPrefixRule struct {
+	prefix string
+	typ    ebpf.ProgramType
+	attach ebpf.AttachType
+}
+
+func (r sectionPrefixRule) Classify(_ string, p *ebpf.ProgramSpec) (ebpf.ProgramType, ebpf.AttachType, bool) {
+	if strings.HasPrefix(p.SectionName, r.prefix) {
+		return r.typ, r.attach, true
+	}
+	return 0, 0, false
+}
+
+// funcNameRule classifies a program by matching its entry function name
+// against a regular expression. This is the fallback used for datapath
+// object files that don't carry useful section names, such as Cilium's own
+// cil_* entrypoints.
+type funcNameRule struct {
+	pattern *regexp.Regexp
+	typ     ebpf.ProgramType
+	attach  ebpf.AttachType
+}
+
+func (r funcNameRule) Classify(name string, _ *ebpf.ProgramSpec) (ebpf.ProgramType, ebpf.AttachType, bool) {
+	if r.pattern.MatchString(name) {
+		return r.typ, r.attach, true
+	}
+	return 0, 0, false
+}
+
+// btfTagRule classifies a program by a clang __attribute__((btf_decl_tag))
+// left on the entry function, e.g. [btf_decl_tag("cilium_prog_type=xdp")].
+// This is the most precise mechanism since it survives arbitrary renames of
+// both the function and the ELF section.
+type btfTagRule struct{}
+
+const btfDeclTagPrefix = "cilium_prog_type="
+
+func (btfTagRule) Classify(_ string, p *ebpf.ProgramSpec) (ebpf.ProgramType, ebpf.AttachType, bool) {
+	if len(p.Instructions) == 0 {
+		return 0, 0, false
+	}
+
+	fn := btf.FuncMetadata(&p.Instructions[0])
+	if fn == nil {
+		return 0, 0, false
+	}
+
+	for _, tag := range fn.Tags {
+		val, ok := strings.CutPrefix(tag, btfDeclTagPrefix)
+		if !ok {
+			continue
+		}
+		if typ, attach, ok := programTypeByName[val]; ok {
+			return typ, attach, true
+		}
+	}
+
+	return 0, 0, false
+}
+
+// programTypeByName maps the cilium_prog_type decl tag value to its
+// ebpf.ProgramType/AttachType pair.
+var programTypeByName = map[string]struct {
+	typ    ebpf.ProgramType
+	attach ebpf.AttachType
+}{
+	"xdp": {ebpf.XDP, ebpf.AttachNone},
+	"tc":  {ebpf.SchedCLS, ebpf.AttachNone},
+}
+
+// defaultProgramClassifier is the package-level, mutable rule chain consulted
+// by classifyProgramTypes. It's seeded with rules covering Cilium's own
+// datapath entrypoints so existing behavior keeps working unmodified; callers
+// extend it via RegisterProgramTypeRule instead of patching this file.
+var defaultProgramClassifier = &ruleClassifier{
+	rules: []ProgramClassifier{
+		btfTagRule{},
+		funcNameRule{regexp.MustCompile(`^cil_xdp_entry$`), ebpf.XDP, ebpf.AttachNone},
+		funcNameRule{
+			regexp.MustCompile(`^(cil_from_container|cil_to_container|cil_from_netdev|cil_from_host|cil_to_netdev|cil_to_host|cil_from_network|cil_to_overlay|cil_from_overlay)$`),
+			ebpf.SchedCLS, ebpf.AttachNone,
+		},
+	},
+}
+
+// ruleClassifier evaluates a slice of ProgramClassifiers in order and
+// returns the result of the first one with an opinion.
+type ruleClassifier struct {
+	mu    sync.RWMutex
+	rules []ProgramClassifier
+}
+
+func (c *ruleClassifier) Classify(name string, p *ebpf.ProgramSpec) (ebpf.ProgramType, ebpf.AttachType, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, r := range c.rules {
+		if typ, attach, ok := r.Classify(name, p); ok {
+			return typ, attach, ok
+		}
+	}
+	return 0, 0, false
+}
+
+func (c *ruleClassifier) register(r ProgramClassifier) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// New rules take priority over the built-ins so out-of-tree callers
+	// can override classification for names Cilium also happens to use.
+	c.rules = append([]ProgramClassifier{r}, c.rules...)
+}
+
+// RegisterProgramTypeRule adds a rule that classifies any ProgramSpec whose
+// SectionName starts with prefix as typ/attach. It takes priority over rules
+// registered earlier (including the built-in cil_* rules), so out-of-tree
+// users and tests can extend or override classification without patching
+// this package.
+func RegisterProgramTypeRule(prefix string, typ ebpf.ProgramType, attach ebpf.AttachType) {
+	defaultProgramClassifier.register(sectionPrefixRule{prefix: prefix, typ: typ, attach: attach})
+}
+
+// classifyProgramTypes sets the type of ProgramSpecs which the library
+// cannot automatically classify due to them being in unrecognized ELF
+// sections. Only programs of type UnspecifiedProgram are modified, and each
+// program is classified independently via defaultProgramClassifier so a
+// single ELF can mix program types.
+func classifyProgramTypes(spec *ebpf.CollectionSpec) {
+	for name, p := range spec.Programs {
+		if p.Type != ebpf.UnspecifiedProgram {
+			continue
+		}
+
+		if typ, attach, ok := defaultProgramClassifier.Classify(name, p); ok {
+			p.Type = typ
+			if attach != ebpf.AttachNone {
+				p.AttachType = attach
+			}
+		}
+	}
+}
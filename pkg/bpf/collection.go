@@ -4,9 +4,11 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/cilium/ebpf"
 	"github.com/cilium/ebpf/asm"
+	"github.com/cilium/ebpf/btf"
 )
 
 const globalDataMap = ".data"
@@ -30,6 +32,10 @@ func LoadCollectionSpec(path string) (*ebpf.CollectionSpec, error) {
 		return nil, err
 	}
 
+	if err := tailCallCompat(spec); err != nil {
+		return nil, err
+	}
+
 	classifyProgramTypes(spec)
 
 	return spec, nil
@@ -96,6 +102,102 @@ func iproute2Compat(spec *ebpf.CollectionSpec) error {
 	return nil
 }
 
+// tailCallCompat recognizes two prog array population conventions beyond the
+// legacy iproute2 X/Y section names handled by iproute2Compat:
+//
+//  1. libbpf-style BTF-defined prog arrays: a map declared with
+//     __uint(type, BPF_MAP_TYPE_PROG_ARRAY) and an __array(values, ...)
+//     initializer, whose Datasec members reference a program by name for
+//     each slot.
+//  2. SEC("tail_call/<mapname>/<slot>") as a migration aid for datapath code
+//     that hasn't been ported to the BTF-defined map convention yet.
+//
+// Both conventions populate MapSpec.Contents the same way iproute2Compat
+// does, and results from all three mechanisms are merged, erroring out if
+// two of them disagree on a slot.
+func tailCallCompat(spec *ebpf.CollectionSpec) error {
+	assigned := make(map[string]map[uint32]string) // map name -> slot -> program
+
+	assign := func(mapName string, slot uint32, prog string) error {
+		m := spec.Maps[mapName]
+		if m == nil {
+			return fmt.Errorf("no map named %s for tail call slot %d", mapName, slot)
+		}
+		if m.Type != ebpf.ProgramArray {
+			return fmt.Errorf("map %s is not a prog array, cannot hold tail call slot %d", mapName, slot)
+		}
+
+		if assigned[mapName] == nil {
+			assigned[mapName] = make(map[uint32]string)
+			// Seed with the slots iproute2Compat already filled in, so a
+			// conflict with those is caught as well.
+			for _, kv := range m.Contents {
+				s, sok := kv.Key.(uint32)
+				p, pok := kv.Value.(string)
+				if sok && pok {
+					assigned[mapName][s] = p
+				}
+			}
+		}
+		if existing, ok := assigned[mapName][slot]; ok && existing != prog {
+			return fmt.Errorf("slot %d of map %s assigned to both %s and %s", slot, mapName, existing, prog)
+		}
+		assigned[mapName][slot] = prog
+
+		m.Contents = append(m.Contents, ebpf.MapKV{Key: slot, Value: prog})
+		return nil
+	}
+
+	// BTF-defined __array(values, ...) prog arrays.
+	for name, m := range spec.Maps {
+		if m.Type != ebpf.ProgramArray || m.Value == nil {
+			continue
+		}
+
+		ds, ok := m.Value.(*btf.Datasec)
+		if !ok {
+			continue
+		}
+
+		for slot, vsi := range ds.Vars {
+			v, ok := vsi.Type.(*btf.Var)
+			if !ok || v.Name == "" {
+				continue
+			}
+			if _, isProg := spec.Programs[v.Name]; !isProg {
+				continue
+			}
+			if err := assign(name, uint32(slot), v.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	// SEC("tail_call/<mapname>/<slot>") migration aid.
+	for progName, p := range spec.Programs {
+		rest, ok := strings.CutPrefix(p.SectionName, "tail_call/")
+		if !ok {
+			continue
+		}
+
+		mapName, slotStr, ok := strings.Cut(rest, "/")
+		if !ok {
+			return fmt.Errorf("section name %q must be of the form tail_call/<map>/<slot>", p.SectionName)
+		}
+
+		var slot uint32
+		if _, err := fmt.Sscanf(slotStr, "%v", &slot); err != nil {
+			return fmt.Errorf("parsing slot in section name %q: %w", p.SectionName, err)
+		}
+
+		if err := assign(mapName, slot, progName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // LoadCollection loads the given spec into the kernel with the specified opts.
 //
 // Any maps marked as pinned in the spec are automatically loaded from the path
@@ -131,42 +233,12 @@ func LoadCollection(spec *ebpf.CollectionSpec, opts ebpf.CollectionOptions) (*eb
 	return coll, nil
 }
 
-// classifyProgramTypes sets the type of ProgramSpecs which the library cannot
-// automatically classify due to them being in unrecognized ELF sections. Only
-// programs of type UnspecifiedProgram are modified.
-//
-// Cilium uses the iproute2 X/Y section name convention for assigning programs
-// to prog array slots, which is also not supported.
-func classifyProgramTypes(spec *ebpf.CollectionSpec) {
-	// Assign a program type based on the first recognized function name.
-	var t ebpf.ProgramType
-	for name := range spec.Programs {
-		switch name {
-		// bpf_xdp.c
-		case "cil_xdp_entry":
-			t = ebpf.XDP
-		case
-			// bpf_lxc.c
-			"cil_from_container", "cil_to_container",
-			// bpf_host.c
-			"cil_from_netdev", "cil_from_host", "cil_to_netdev", "cil_to_host",
-			// bpf_network.c
-			"cil_from_network",
-			// bpf_overlay.c
-			"cil_to_overlay", "cil_from_overlay":
-			t = ebpf.SchedCLS
-		default:
-			continue
-		}
-
-		break
-	}
-
-	for _, p := range spec.Programs {
-		if p.Type == ebpf.UnspecifiedProgram {
-			p.Type = t
-		}
-	}
+// globalVar describes a single variable backed by the global data map, as
+// derived from the Datasec's VarSecinfo entries.
+type globalVar struct {
+	name   string
+	size   uint32
+	signed bool
 }
 
 // inlineGlobalData replaces all map loads from a global data section with
@@ -174,9 +246,9 @@ func classifyProgramTypes(spec *ebpf.CollectionSpec) {
 // loader. This is done for compatibility with kernels that don't support
 // global data maps yet.
 //
-// Currently, all map reads are expected to be 32 bits wide until BTF MapKV
-// can be fully accessed by the caller, which would allow for querying value
-// widths.
+// The width and signedness of each load is derived from the BTF Datasec
+// describing the .data map, so 1/2/4/8-byte variables (e.g. const volatile
+// __u64) are all handled correctly instead of assuming a fixed 32-bit width.
 //
 // This works in conjunction with the __fetch macros in the datapath, which
 // emit direct array accesses instead of memory loads with an offset from the
@@ -191,6 +263,11 @@ func inlineGlobalData(spec *ebpf.CollectionSpec) error {
 		return nil
 	}
 
+	vars, err := globalVars(spec, globalDataMap)
+	if err != nil {
+		return fmt.Errorf("reading BTF for %s: %w", globalDataMap, err)
+	}
+
 	// Don't attempt to create an empty map .bss in the kernel.
 	delete(spec.Maps, ".bss")
 
@@ -215,21 +292,133 @@ func inlineGlobalData(spec *ebpf.CollectionSpec) error {
 			// Equivalent to Instruction.mapOffset().
 			off := uint32(uint64(ins.Constant) >> 32)
 
-			if off%4 != 0 {
-				return fmt.Errorf("global const access at offset %d not 32-bit aligned", off)
+			v, err := vars.at(off)
+			if err != nil {
+				return err
 			}
 
-			imm := spec.ByteOrder.Uint32(data[off : off+4])
+			imm, err := loadImm(data, spec.ByteOrder, off, v)
+			if err != nil {
+				return err
+			}
 
 			// Replace the map load with an immediate load. Must be a dword load
 			// to match the instruction width of a map load.
-			prog.Instructions[i] = asm.LoadImm(ins.Dst, int64(imm), asm.DWord)
+			prog.Instructions[i] = asm.LoadImm(ins.Dst, imm, asm.DWord)
 		}
 	}
 
 	return nil
 }
 
+// globalVarTable maps the byte offset a VarSecinfo starts at within the
+// Datasec's data blob to the variable occupying it.
+type globalVarTable map[uint32]globalVar
+
+// at returns the variable covering the byte range [off, off+size) for some
+// size, erroring out if off doesn't fall within exactly one known variable or
+// straddles the boundary between two.
+func (t globalVarTable) at(off uint32) (globalVar, error) {
+	for start, v := range t {
+		if off < start || off >= start+v.size {
+			continue
+		}
+		if off != start {
+			return globalVar{}, fmt.Errorf("global const access at offset %d is a partial read of variable %q", off, v.name)
+		}
+		return v, nil
+	}
+
+	return globalVar{}, fmt.Errorf("no BTF variable found covering offset %d in %s", off, globalDataMap)
+}
+
+// globalVars derives a globalVarTable from the BTF Datasec describing mapName
+// in spec, so inlineGlobalData can determine the width and signedness of each
+// relocated read.
+func globalVars(spec *ebpf.CollectionSpec, mapName string) (globalVarTable, error) {
+	m := spec.Maps[mapName]
+	if m == nil || m.Value == nil {
+		return nil, fmt.Errorf("missing BTF for %s, rebuild with a compiler that emits BTF datasec info", mapName)
+	}
+
+	ds, ok := m.Value.(*btf.Datasec)
+	if !ok {
+		return nil, fmt.Errorf("BTF type for %s is %T, not a Datasec", mapName, m.Value)
+	}
+
+	table := make(globalVarTable, len(ds.Vars))
+	for _, vsi := range ds.Vars {
+		bv, ok := vsi.Type.(*btf.Var)
+		if !ok {
+			return nil, fmt.Errorf("VarSecinfo at offset %d in %s is not a Var: %T", vsi.Offset, mapName, vsi.Type)
+		}
+
+		size, signed, err := varWidth(bv)
+		if err != nil {
+			return nil, fmt.Errorf("variable %q: %w", bv.Name, err)
+		}
+		if size != vsi.Size {
+			return nil, fmt.Errorf("variable %q: BTF type size %d doesn't match VarSecinfo size %d", bv.Name, size, vsi.Size)
+		}
+
+		if existing, overlap := table[vsi.Offset]; overlap {
+			return nil, fmt.Errorf("variables %q and %q overlap at offset %d in %s", bv.Name, existing.name, vsi.Offset, mapName)
+		}
+
+		table[vsi.Offset] = globalVar{name: bv.Name, size: size, signed: signed}
+	}
+
+	return table, nil
+}
+
+// varWidth resolves the byte size and signedness of the scalar type
+// underlying a BTF Var, skipping through any qualifiers the compiler inserts
+// for `const volatile` declarations.
+func varWidth(v *btf.Var) (size uint32, signed bool, err error) {
+	typ := btf.UnderlyingType(v.Type)
+
+	switch t := typ.(type) {
+	case *btf.Int:
+		return t.Size, t.Encoding == btf.Signed, nil
+	case *btf.Enum:
+		return t.Size, true, nil
+	default:
+		return 0, false, fmt.Errorf("unsupported BTF type %T for global constant", typ)
+	}
+}
+
+// loadImm reads exactly v.size bytes of data at off and sign- or
+// zero-extends it to an int64 suitable for asm.LoadImm.
+func loadImm(data []byte, order binary.ByteOrder, off uint32, v globalVar) (int64, error) {
+	if int(off+v.size) > len(data) {
+		return 0, fmt.Errorf("variable %q at offset %d exceeds %s length %d", v.name, off, globalDataMap, len(data))
+	}
+
+	raw := data[off : off+v.size]
+
+	var u uint64
+	switch v.size {
+	case 1:
+		u = uint64(raw[0])
+	case 2:
+		u = uint64(order.Uint16(raw))
+	case 4:
+		u = uint64(order.Uint32(raw))
+	case 8:
+		u = order.Uint64(raw)
+	default:
+		return 0, fmt.Errorf("variable %q has unsupported width %d", v.name, v.size)
+	}
+
+	if !v.signed {
+		return int64(u), nil
+	}
+
+	// Sign-extend from the variable's native width to int64.
+	shift := 64 - v.size*8
+	return int64(u<<shift) >> shift, nil
+}
+
 // globalData gets the contents of the first entry in the global data map
 // and removes it from the spec to prevent it from being created in the kernel.
 func globalData(spec *ebpf.CollectionSpec) ([]byte, error) {
@@ -0,0 +1,71 @@
+package bpf
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/btf"
+)
+
+// vmlinuxBTFPath is the standard sysfs location of the running kernel's BTF,
+// exposed when CONFIG_DEBUG_INFO_BTF is enabled.
+const vmlinuxBTFPath = "/sys/kernel/btf/vmlinux"
+
+// LoadOptions wraps ebpf.CollectionOptions with knobs for CO-RE relocation.
+type LoadOptions struct {
+	ebpf.CollectionOptions
+
+	// CORE enables CO-RE relocation against KernelBTF (or the running
+	// kernel's BTF if KernelBTF is nil) while loading the collection.
+	// Disabled by default since most Cilium datapath ELFs are still
+	// compiled without CO-RE relocations.
+	//
+	// Relocation itself is performed by cilium/ebpf as part of loading
+	// the collection, using each ProgramSpec's own embedded BTF; CORE
+	// here only controls which kernel BTF that relocation is resolved
+	// against, via CollectionOptions.Programs.KernelTypes.
+	CORE bool
+
+	// KernelBTF overrides the BTF CO-RE relocations are resolved against.
+	// If nil and CORE is true, it's loaded from vmlinuxBTFPath.
+	KernelBTF *btf.Spec
+}
+
+// LoadCollectionWithCORE is LoadCollection with opts.KernelBTF (or the
+// running kernel's BTF) set as the CO-RE relocation target beforehand. When
+// opts.CORE is false this is identical to
+// LoadCollection(spec, opts.CollectionOptions).
+//
+// This makes it possible to ship a single CO-RE-enabled ELF across kernel
+// versions instead of compiling kernel-version-conditional C, provided the
+// datapath C code emits relocatable field/type accesses (BPF_CORE_READ and
+// friends) instead of hardcoding struct layouts. The relocation pass itself,
+// including poisoning accesses to fields the target kernel doesn't have, is
+// handled by cilium/ebpf while loading the collection; this function only
+// arranges for it to run against the right kernel BTF.
+func LoadCollectionWithCORE(spec *ebpf.CollectionSpec, opts LoadOptions) (*ebpf.Collection, error) {
+	if opts.CORE {
+		kernelBTF := opts.KernelBTF
+		if kernelBTF == nil {
+			var err error
+			kernelBTF, err = loadVMLinuxBTF()
+			if err != nil {
+				return nil, fmt.Errorf("loading kernel BTF: %w", err)
+			}
+		}
+		opts.Programs.KernelTypes = kernelBTF
+	}
+
+	return LoadCollection(spec, opts.CollectionOptions)
+}
+
+func loadVMLinuxBTF() (*btf.Spec, error) {
+	f, err := os.Open(vmlinuxBTFPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return btf.LoadSpecFromReader(f)
+}
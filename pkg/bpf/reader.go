@@ -0,0 +1,174 @@
+package bpf
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"sort"
+
+	"github.com/cilium/ebpf"
+)
+
+// LoadCollectionSpecFromReader parses an eBPF ELF read from r into a
+// CollectionSpec, running the same iproute2Compat and classifyProgramTypes
+// pipeline as LoadCollectionSpec. Unlike the path-based variant, this allows
+// callers to load bytecode that isn't a file on disk, e.g. an ELF embedded
+// in the binary via //go:embed.
+func LoadCollectionSpecFromReader(r io.ReaderAt) (*ebpf.CollectionSpec, error) {
+	spec, err := ebpf.LoadCollectionSpecFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := iproute2Compat(spec); err != nil {
+		return nil, err
+	}
+
+	if err := tailCallCompat(spec); err != nil {
+		return nil, err
+	}
+
+	classifyProgramTypes(spec)
+
+	return spec, nil
+}
+
+// LoadCollectionSpecFromFS is a convenience wrapper around
+// LoadCollectionSpecFromReader for loading an ELF out of an fs.FS, such as an
+// embed.FS populated via //go:embed.
+func LoadCollectionSpecFromFS(fsys fs.FS, path string) (*ebpf.CollectionSpec, error) {
+	f, err := fsys.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", path, err)
+	}
+	defer f.Close()
+
+	ra, ok := f.(io.ReaderAt)
+	if !ok {
+		b, err := io.ReadAll(f)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+		ra = &bytesReaderAt{b}
+	}
+
+	spec, err := LoadCollectionSpecFromReader(ra)
+	if err != nil {
+		return nil, fmt.Errorf("loading %s: %w", path, err)
+	}
+
+	return spec, nil
+}
+
+// bytesReaderAt adapts a byte slice to io.ReaderAt for fs.File
+// implementations that don't already support it.
+type bytesReaderAt struct {
+	b []byte
+}
+
+func (r *bytesReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 || off > int64(len(r.b)) {
+		return 0, fmt.Errorf("offset %d out of range", off)
+	}
+	n := copy(p, r.b[off:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// LoadCollectionSpecs loads and merges multiple ELF object files, keyed by
+// an arbitrary caller-chosen name (typically their original file name), into
+// a single CollectionSpec. Objects are merged in ascending order of their
+// name so the result is deterministic regardless of map iteration order.
+// Prog-array tail-call references are merged across the inputs, erroring out
+// if two objects assign conflicting programs to the same map and slot; any
+// other colliding map name is rejected outright rather than silently picking
+// whichever object was merged first.
+func LoadCollectionSpecs(objs map[string][]byte) (*ebpf.CollectionSpec, error) {
+	names := make([]string, 0, len(objs))
+	for name := range objs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var merged *ebpf.CollectionSpec
+
+	for _, name := range names {
+		spec, err := LoadCollectionSpecFromReader(&bytesReaderAt{objs[name]})
+		if err != nil {
+			return nil, fmt.Errorf("loading %s: %w", name, err)
+		}
+
+		if merged == nil {
+			merged = spec
+			continue
+		}
+
+		if err := mergeCollectionSpecs(merged, spec); err != nil {
+			return nil, fmt.Errorf("merging %s: %w", name, err)
+		}
+	}
+
+	return merged, nil
+}
+
+// mergeCollectionSpecs folds src's maps and programs into dst in place,
+// rejecting conflicting prog-array slot assignments between the two and any
+// other map name collision outright, since there's no generally correct way
+// to merge two independently-defined maps (e.g. .rodata/.bss/a config map)
+// that happen to share a name.
+func mergeCollectionSpecs(dst, src *ebpf.CollectionSpec) error {
+	for name, m := range src.Maps {
+		existing, ok := dst.Maps[name]
+		if !ok {
+			dst.Maps[name] = m
+			continue
+		}
+
+		if existing.Type != ebpf.ProgramArray || m.Type != ebpf.ProgramArray {
+			return fmt.Errorf("map %s defined in more than one object", name)
+		}
+
+		if err := mergeProgArrayContents(existing, m); err != nil {
+			return fmt.Errorf("map %s: %w", name, err)
+		}
+	}
+
+	for name, p := range src.Programs {
+		if _, ok := dst.Programs[name]; ok {
+			return fmt.Errorf("program %s defined in more than one object", name)
+		}
+		dst.Programs[name] = p
+	}
+
+	return nil
+}
+
+// mergeProgArrayContents merges src's MapKV slot assignments into dst,
+// erroring on conflicting slot assignments.
+func mergeProgArrayContents(dst, src *ebpf.MapSpec) error {
+	slots := make(map[uint32]string, len(dst.Contents))
+	for _, kv := range dst.Contents {
+		if slot, ok := kv.Key.(uint32); ok {
+			slots[slot] = fmt.Sprint(kv.Value)
+		}
+	}
+
+	for _, kv := range src.Contents {
+		slot, ok := kv.Key.(uint32)
+		if !ok {
+			dst.Contents = append(dst.Contents, kv)
+			continue
+		}
+
+		if prog, conflict := slots[slot]; conflict {
+			return fmt.Errorf("slot %d already assigned to %s, cannot also assign %v", slot, prog, kv.Value)
+		}
+
+		slots[slot] = fmt.Sprint(kv.Value)
+		dst.Contents = append(dst.Contents, kv)
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package status
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/models"
+)
+
+// diffEvents computes the PeerEvents that turn prev into next, keying peers
+// by PublicKey within each interface (by Name) rather than by slice index,
+// since peers churn independently of their position in the list.
+func diffEvents(prev, next *models.WireguardStatus) []PeerEvent {
+	prevIfaces := peersByInterface(prev)
+	nextIfaces := peersByInterface(next)
+
+	var events []PeerEvent
+
+	for _, name := range sortedInterfaceNames(nextIfaces) {
+		events = append(events, diffInterfacePeers(name, prevIfaces[name], nextIfaces[name])...)
+	}
+
+	for _, name := range sortedInterfaceNames(prevIfaces) {
+		if _, ok := nextIfaces[name]; ok {
+			continue
+		}
+		prevPeers := prevIfaces[name]
+		for _, key := range sortedKeys(prevPeers) {
+			events = append(events, PeerEvent{Type: PeerRemoved, Interface: name, Peer: prevPeers[key]})
+		}
+	}
+
+	return events
+}
+
+// sortedInterfaceNames returns m's keys in sorted order.
+func sortedInterfaceNames(m map[string]map[string]*models.WireguardPeer) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// diffInterfacePeers diffs a single interface's peers, keyed by PublicKey.
+func diffInterfacePeers(iface string, prev, next map[string]*models.WireguardPeer) []PeerEvent {
+	var events []PeerEvent
+
+	for _, key := range sortedKeys(next) {
+		nextPeer := next[key]
+		prevPeer, existed := prev[key]
+		switch {
+		case !existed:
+			events = append(events, PeerEvent{Type: PeerAdded, Interface: iface, Peer: nextPeer})
+		case peerChanged(prevPeer, nextPeer):
+			events = append(events, PeerEvent{Type: PeerUpdated, Interface: iface, Peer: nextPeer})
+		}
+	}
+
+	for _, key := range sortedKeys(prev) {
+		if _, ok := next[key]; !ok {
+			events = append(events, PeerEvent{Type: PeerRemoved, Interface: iface, Peer: prev[key]})
+		}
+	}
+
+	return events
+}
+
+// peerChanged reports whether a peer's AllowedIps or LastHandshakeTime
+// differ between two snapshots, per PeerUpdated's contract. Differing only in
+// AllowedIps order is treated as a change: this package doesn't assume the
+// list is sorted upstream.
+func peerChanged(a, b *models.WireguardPeer) bool {
+	if !time.Time(a.LastHandshakeTime).Equal(time.Time(b.LastHandshakeTime)) {
+		return true
+	}
+
+	if len(a.AllowedIps) != len(b.AllowedIps) {
+		return true
+	}
+	for i := range a.AllowedIps {
+		if a.AllowedIps[i] != b.AllowedIps[i] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// peersByInterface indexes every interface's peers by PublicKey, keyed in
+// turn by interface name. A nil status yields an empty index.
+func peersByInterface(s *models.WireguardStatus) map[string]map[string]*models.WireguardPeer {
+	ifaces := make(map[string]map[string]*models.WireguardPeer)
+	if s == nil {
+		return ifaces
+	}
+
+	for _, iface := range s.Interfaces {
+		if iface == nil {
+			continue
+		}
+		peers := make(map[string]*models.WireguardPeer, len(iface.Peers))
+		for _, peer := range iface.Peers {
+			if peer == nil {
+				continue
+			}
+			peers[peer.PublicKey] = peer
+		}
+		ifaces[iface.Name] = peers
+	}
+
+	return ifaces
+}
+
+// sortedKeys returns m's keys in sorted order, so event emission order is
+// reproducible between runs given the same pair of snapshots.
+func sortedKeys(m map[string]*models.WireguardPeer) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
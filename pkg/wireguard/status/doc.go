@@ -0,0 +1,9 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+// Package status keeps a canonical models.WireguardStatus and turns each
+// update into per-peer add/remove/update events, so observers like Hubble
+// can react to individual peer churn without re-serializing and diffing the
+// full Interfaces[*].Peers[*] tree on every poll -- the cost
+// WireguardStatus.DeepCopyInto pays on every status refresh.
+package status
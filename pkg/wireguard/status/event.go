@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package status
+
+import "github.com/cilium/cilium/api/v1/models"
+
+// EventType identifies what changed about a peer between two snapshots.
+type EventType int
+
+const (
+	// PeerAdded is emitted when a peer's PublicKey is present on an
+	// interface and was absent from it in the previous snapshot.
+	PeerAdded EventType = iota
+	// PeerRemoved is emitted when a previously observed peer's PublicKey
+	// is no longer present on an interface.
+	PeerRemoved
+	// PeerUpdated is emitted when a peer's AllowedIps or LastHandshakeTime
+	// changed since the previous snapshot.
+	PeerUpdated
+)
+
+func (t EventType) String() string {
+	switch t {
+	case PeerAdded:
+		return "added"
+	case PeerRemoved:
+		return "removed"
+	case PeerUpdated:
+		return "updated"
+	default:
+		return "unknown"
+	}
+}
+
+// PeerEvent describes a single peer's change on a single WireGuard
+// interface between two consecutive Update calls.
+type PeerEvent struct {
+	Type EventType
+
+	// Interface is the owning WireguardInterface's name.
+	Interface string
+
+	// Peer is the peer's state as of the snapshot that produced this
+	// event: the new state for Added/Updated, the last known state for
+	// Removed.
+	Peer *models.WireguardPeer
+}
@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package status
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cilium/cilium/api/v1/models"
+)
+
+// subscriberBuffer is the capacity of each subscriber's channel: how many
+// events its dispatch goroutine can hand over before it blocks on a
+// subscriber that isn't reading, while further events wait in the
+// subscriber's in-memory queue. Sized for a burst of churn across every peer
+// of a handful of interfaces.
+const subscriberBuffer = 256
+
+// maxQueuedEvents caps a subscriber's internal backlog once its channel is
+// also full. A subscriber stuck this far behind is treated as unrecoverable
+// for the purposes of memory bounding: further events evict the oldest
+// queued ones rather than growing without limit, trading a gap in that one
+// subscriber's event stream for keeping Update non-blocking and the
+// Tracker's memory use bounded regardless of how long a stalled subscriber's
+// context stays uncanceled.
+const maxQueuedEvents = 16 * subscriberBuffer
+
+// Tracker holds the last-known WireguardStatus and fans out a PeerEvent per
+// added, removed, or updated peer on every Update call. The zero value is
+// not usable; construct one with NewTracker.
+type Tracker struct {
+	mu   sync.Mutex
+	cur  *models.WireguardStatus
+	subs map[*subscriber]struct{}
+}
+
+// NewTracker returns an empty Tracker: Snapshot returns nil until the first
+// Update call, and Subscribe delivers events starting from whichever Update
+// call happens next.
+func NewTracker() *Tracker {
+	return &Tracker{subs: make(map[*subscriber]struct{})}
+}
+
+// Update replaces the Tracker's canonical snapshot with next and publishes
+// a PeerEvent to every subscriber for each peer that was added, removed, or
+// had its AllowedIps or LastHandshakeTime change since the previous
+// snapshot. Update takes its own DeepCopy of next, so the caller is free to
+// mutate or reuse it once Update returns.
+//
+// Update never blocks on a subscriber: each subscriber has its own dispatch
+// goroutine and queue, so one subscriber that stops draining its channel
+// delays only itself, not Update's caller or any other subscriber.
+//
+// Update holds the Tracker's lock for the full call, including event
+// dispatch, so a concurrent Snapshot or Subscribe can never observe the new
+// state in between t.cur being committed and the matching events being
+// enqueued to subscribers -- and concurrent Update calls are serialized
+// against each other, so subscribers always observe events in the same
+// order Update was called in.
+func (t *Tracker) Update(next *models.WireguardStatus) {
+	snapshot := next.DeepCopy()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	prev := t.cur
+	t.cur = snapshot
+
+	if len(t.subs) == 0 {
+		return
+	}
+
+	events := diffEvents(prev, snapshot)
+	for sub := range t.subs {
+		sub.enqueue(events)
+	}
+}
+
+// Snapshot returns a DeepCopy of the current WireguardStatus, safe for a
+// late-joining caller to read without racing a concurrent Update.
+func (t *Tracker) Snapshot() *models.WireguardStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.cur.DeepCopy()
+}
+
+// Subscribe registers a new PeerEvent subscriber. The returned channel
+// receives every event from the next Update call onward, except those
+// evicted once its backlog exceeds maxQueuedEvents, until ctx is
+// canceled, at which point the subscriber is unregistered and its channel is
+// abandoned -- not closed, since its dispatch goroutine may still be mid-send
+// when ctx is canceled, and closing a channel a concurrent sender might still
+// write to is a use-after-close panic waiting to happen. Callers should
+// range-select on ctx.Done() themselves rather than rely on the channel
+// closing; callers that also need the state as of subscription time should
+// call Snapshot first.
+//
+// The error return is always nil today; it's reserved for a future cap on
+// the number of concurrent subscribers.
+func (t *Tracker) Subscribe(ctx context.Context) (<-chan PeerEvent, error) {
+	sub := newSubscriber(ctx)
+
+	t.mu.Lock()
+	t.subs[sub] = struct{}{}
+	t.mu.Unlock()
+
+	go sub.run()
+
+	go func() {
+		<-ctx.Done()
+		t.mu.Lock()
+		delete(t.subs, sub)
+		t.mu.Unlock()
+	}()
+
+	return sub.ch, nil
+}
+
+// subscriber decouples a single Subscribe caller's delivery rate from
+// Update's caller and from every other subscriber. enqueue appends to an
+// internal, mutex-guarded queue and returns immediately; a dedicated
+// goroutine (run) drains that queue into ch at whatever pace the subscriber
+// can keep up with.
+type subscriber struct {
+	ch  chan PeerEvent
+	ctx context.Context
+
+	mu     sync.Mutex
+	cond   *sync.Cond
+	queue  []PeerEvent
+	closed bool
+}
+
+func newSubscriber(ctx context.Context) *subscriber {
+	sub := &subscriber{
+		ch:  make(chan PeerEvent, subscriberBuffer),
+		ctx: ctx,
+	}
+	sub.cond = sync.NewCond(&sub.mu)
+	return sub
+}
+
+// enqueue appends events to the subscriber's queue without blocking on the
+// subscriber's channel. If the queue is already at maxQueuedEvents, the
+// oldest queued events are evicted to make room, per the maxQueuedEvents
+// doc comment.
+func (s *subscriber) enqueue(events []PeerEvent) {
+	if len(events) == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	s.queue = append(s.queue, events...)
+	if over := len(s.queue) - maxQueuedEvents; over > 0 {
+		s.queue = s.queue[over:]
+	}
+	s.mu.Unlock()
+	s.cond.Signal()
+}
+
+// run drains the subscriber's queue into ch in order until ctx is canceled.
+func (s *subscriber) run() {
+	go func() {
+		<-s.ctx.Done()
+		s.mu.Lock()
+		s.closed = true
+		s.mu.Unlock()
+		s.cond.Signal()
+	}()
+
+	for {
+		s.mu.Lock()
+		for len(s.queue) == 0 && !s.closed {
+			s.cond.Wait()
+		}
+		if len(s.queue) == 0 {
+			s.mu.Unlock()
+			return
+		}
+		ev := s.queue[0]
+		s.queue = s.queue[1:]
+		s.mu.Unlock()
+
+		select {
+		case s.ch <- ev:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}